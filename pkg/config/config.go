@@ -0,0 +1,163 @@
+// Package config loads an OpenTelemetry declarative configuration document
+// (YAML or JSON, file_format "0.3") and materializes it into initialized
+// LoggerProvider/TracerProvider/MeterProvider instances, as an alternative
+// to hand-populating logger.Config/tracer.Config/meter.Config.
+package config
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+
+	"go.opentelemetry.io/otel"
+	logglobal "go.opentelemetry.io/otel/log/global"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SDK bundles the providers NewSDK initialized and a single Shutdown that
+// flushes all of them.
+type SDK struct {
+	LoggerProvider *sdklog.LoggerProvider
+	TracerProvider *sdktrace.TracerProvider
+	MeterProvider  *sdkmetric.MeterProvider
+}
+
+// Shutdown flushes and closes every provider that was configured, joining
+// any errors.
+func (s SDK) Shutdown(ctx context.Context) error {
+	var errs []error
+	if s.LoggerProvider != nil {
+		if err := s.LoggerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("logger provider: %w", err))
+		}
+	}
+	if s.TracerProvider != nil {
+		if err := s.TracerProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("tracer provider: %w", err))
+		}
+	}
+	if s.MeterProvider != nil {
+		if err := s.MeterProvider.Shutdown(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("meter provider: %w", err))
+		}
+	}
+	switch len(errs) {
+	case 0:
+		return nil
+	case 1:
+		return errs[0]
+	default:
+		msgs := make([]string, len(errs))
+		for i, e := range errs {
+			msgs[i] = e.Error()
+		}
+		return fmt.Errorf("shutdown: %s", strings.Join(msgs, "; "))
+	}
+}
+
+// NewSDK parses a declarative configuration document (YAML or JSON) and
+// builds the providers it describes, installing each as the global
+// provider for its signal.
+func NewSDK(ctx context.Context, cfgBytes []byte) (SDK, error) {
+	var fc fileConfig
+	if looksLikeJSON(cfgBytes) {
+		if err := json.Unmarshal(cfgBytes, &fc); err != nil {
+			return SDK{}, fmt.Errorf("config: parse json: %w", err)
+		}
+	} else if err := yaml.Unmarshal(cfgBytes, &fc); err != nil {
+		return SDK{}, fmt.Errorf("config: parse yaml: %w", err)
+	}
+
+	res, err := buildResource(ctx, fc.Resource)
+	if err != nil {
+		return SDK{}, fmt.Errorf("config: %w", err)
+	}
+
+	var sdk SDK
+
+	if fc.LoggerProvider != nil {
+		lp, err := buildLoggerProvider(ctx, res, fc.LoggerProvider)
+		if err != nil {
+			return SDK{}, fmt.Errorf("config: logger_provider: %w", err)
+		}
+		logglobal.SetLoggerProvider(lp)
+		sdk.LoggerProvider = lp
+	}
+
+	if fc.TracerProvider != nil {
+		tp, err := buildTracerProvider(ctx, res, fc.TracerProvider)
+		if err != nil {
+			return SDK{}, fmt.Errorf("config: tracer_provider: %w", err)
+		}
+		otel.SetTracerProvider(tp)
+		sdk.TracerProvider = tp
+	}
+
+	if fc.MeterProvider != nil {
+		mp, err := buildMeterProvider(ctx, res, fc.MeterProvider)
+		if err != nil {
+			return SDK{}, fmt.Errorf("config: meter_provider: %w", err)
+		}
+		otel.SetMeterProvider(mp)
+		sdk.MeterProvider = mp
+	}
+
+	return sdk, nil
+}
+
+func looksLikeJSON(b []byte) bool {
+	trimmed := strings.TrimSpace(string(b))
+	return strings.HasPrefix(trimmed, "{")
+}
+
+// buildResource merges explicit config attributes with OTEL_RESOURCE_ATTRIBUTES,
+// config values winning on conflict.
+func buildResource(ctx context.Context, rc *resourceConfig) (*resource.Resource, error) {
+	opts := []resource.Option{
+		resource.WithFromEnv(),
+		resource.WithHost(),
+		resource.WithTelemetrySDK(),
+	}
+	if rc != nil && len(rc.Attributes) > 0 {
+		var kvs []attrKV
+		for k, v := range rc.Attributes {
+			kvs = append(kvs, attrKV{k, expandEnv(v)})
+		}
+		opts = append(opts, resource.WithAttributes(toAttributes(kvs)...))
+	}
+	res, err := resource.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("resource: %w", err)
+	}
+	return res, nil
+}
+
+// expandEnv substitutes ${VAR} references using os.Getenv, leaving the
+// reference untouched if the variable isn't set.
+var envRefPattern = regexp.MustCompile(`\$\{(\w+)\}`)
+
+func expandEnv(s string) string {
+	return envRefPattern.ReplaceAllStringFunc(s, func(ref string) string {
+		name := envRefPattern.FindStringSubmatch(ref)[1]
+		if v, ok := os.LookupEnv(name); ok {
+			return v
+		}
+		return ref
+	})
+}
+
+func millis(d *int64, def time.Duration) time.Duration {
+	if d == nil {
+		return def
+	}
+	return time.Duration(*d) * time.Millisecond
+}
@@ -0,0 +1,229 @@
+package config
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+
+	logexp "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	loghttpexp "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	metricexp "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	metrichttpexp "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetrichttp"
+	traceexp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	tracehttpexp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+type attrKV struct {
+	key, val string
+}
+
+func toAttributes(kvs []attrKV) []attribute.KeyValue {
+	out := make([]attribute.KeyValue, len(kvs))
+	for i, kv := range kvs {
+		out[i] = attribute.String(kv.key, kv.val)
+	}
+	return out
+}
+
+func buildLoggerProvider(ctx context.Context, res *resource.Resource, pc *providerConfig) (*sdklog.LoggerProvider, error) {
+	opts := []sdklog.LoggerProviderOption{sdklog.WithResource(res)}
+	for _, p := range pc.Processors {
+		switch {
+		case p.Batch != nil:
+			exp, err := newLogExporter(ctx, p.Batch.Exporter)
+			if err != nil {
+				return nil, err
+			}
+			bopts := []sdklog.BatchProcessorOption{
+				sdklog.WithExportInterval(millis(p.Batch.ScheduleDelayMillis, 0)),
+			}
+			if p.Batch.MaxQueueSize != nil {
+				bopts = append(bopts, sdklog.WithMaxQueueSize(*p.Batch.MaxQueueSize))
+			}
+			opts = append(opts, sdklog.WithProcessor(sdklog.NewBatchProcessor(exp, bopts...)))
+		case p.Simple != nil:
+			exp, err := newLogExporter(ctx, p.Simple.Exporter)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, sdklog.WithProcessor(sdklog.NewSimpleProcessor(exp)))
+		}
+	}
+	return sdklog.NewLoggerProvider(opts...), nil
+}
+
+func buildTracerProvider(ctx context.Context, res *resource.Resource, pc *providerConfig) (*sdktrace.TracerProvider, error) {
+	opts := []sdktrace.TracerProviderOption{sdktrace.WithResource(res)}
+	for _, p := range pc.Processors {
+		switch {
+		case p.Batch != nil:
+			exp, err := newTraceExporter(ctx, p.Batch.Exporter)
+			if err != nil {
+				return nil, err
+			}
+			bopts := []sdktrace.BatchSpanProcessorOption{
+				sdktrace.WithBatchTimeout(millis(p.Batch.ScheduleDelayMillis, 0)),
+			}
+			if p.Batch.MaxQueueSize != nil {
+				bopts = append(bopts, sdktrace.WithMaxQueueSize(*p.Batch.MaxQueueSize))
+			}
+			opts = append(opts, sdktrace.WithSpanProcessor(sdktrace.NewBatchSpanProcessor(exp, bopts...)))
+		case p.Simple != nil:
+			exp, err := newTraceExporter(ctx, p.Simple.Exporter)
+			if err != nil {
+				return nil, err
+			}
+			opts = append(opts, sdktrace.WithSpanProcessor(sdktrace.NewSimpleSpanProcessor(exp)))
+		}
+	}
+	return sdktrace.NewTracerProvider(opts...), nil
+}
+
+func buildMeterProvider(ctx context.Context, res *resource.Resource, pc *providerConfig) (*sdkmetric.MeterProvider, error) {
+	opts := []sdkmetric.Option{sdkmetric.WithResource(res)}
+	for _, r := range pc.Readers {
+		if r.Periodic == nil {
+			continue
+		}
+		exp, err := newMetricExporter(ctx, r.Periodic.Exporter)
+		if err != nil {
+			return nil, err
+		}
+		ropts := []sdkmetric.PeriodicReaderOption{
+			sdkmetric.WithInterval(millis(r.Periodic.IntervalMillis, 0)),
+		}
+		opts = append(opts, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(exp, ropts...)))
+	}
+	return sdkmetric.NewMeterProvider(opts...), nil
+}
+
+func newLogExporter(ctx context.Context, ec exporterConfig) (sdklog.Exporter, error) {
+	switch {
+	case ec.OTLP != nil:
+		o := ec.OTLP
+		if isHTTPProtocol(o.Protocol) {
+			opts := []loghttpexp.Option{
+				loghttpexp.WithEndpoint(expandEnv(o.Endpoint)),
+				loghttpexp.WithHeaders(expandEnvMap(o.Headers)),
+			}
+			if o.Insecure != nil && *o.Insecure {
+				opts = append(opts, loghttpexp.WithInsecure())
+			}
+			if o.Timeout != nil {
+				opts = append(opts, loghttpexp.WithTimeout(millis(o.Timeout, 0)))
+			}
+			return loghttpexp.New(ctx, opts...)
+		}
+		opts := []logexp.Option{
+			logexp.WithEndpoint(expandEnv(o.Endpoint)),
+			logexp.WithHeaders(expandEnvMap(o.Headers)),
+		}
+		if o.Insecure != nil && *o.Insecure {
+			opts = append(opts, logexp.WithInsecure())
+		}
+		if o.Timeout != nil {
+			opts = append(opts, logexp.WithTimeout(millis(o.Timeout, 0)))
+		}
+		return logexp.New(ctx, opts...)
+	case ec.Console != nil:
+		return stdoutlog.New(stdoutlog.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("exporter: no otlp or console configured")
+	}
+}
+
+func newTraceExporter(ctx context.Context, ec exporterConfig) (sdktrace.SpanExporter, error) {
+	switch {
+	case ec.OTLP != nil:
+		o := ec.OTLP
+		if isHTTPProtocol(o.Protocol) {
+			opts := []tracehttpexp.Option{
+				tracehttpexp.WithEndpoint(expandEnv(o.Endpoint)),
+				tracehttpexp.WithHeaders(expandEnvMap(o.Headers)),
+			}
+			if o.Insecure != nil && *o.Insecure {
+				opts = append(opts, tracehttpexp.WithInsecure())
+			}
+			if o.Timeout != nil {
+				opts = append(opts, tracehttpexp.WithTimeout(millis(o.Timeout, 0)))
+			}
+			return tracehttpexp.New(ctx, opts...)
+		}
+		opts := []traceexp.Option{
+			traceexp.WithEndpoint(expandEnv(o.Endpoint)),
+			traceexp.WithHeaders(expandEnvMap(o.Headers)),
+		}
+		if o.Insecure != nil && *o.Insecure {
+			opts = append(opts, traceexp.WithInsecure())
+		}
+		if o.Timeout != nil {
+			opts = append(opts, traceexp.WithTimeout(millis(o.Timeout, 0)))
+		}
+		return traceexp.New(ctx, opts...)
+	case ec.Console != nil:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	default:
+		return nil, fmt.Errorf("exporter: no otlp or console configured")
+	}
+}
+
+func newMetricExporter(ctx context.Context, ec exporterConfig) (sdkmetric.Exporter, error) {
+	switch {
+	case ec.OTLP != nil:
+		o := ec.OTLP
+		if isHTTPProtocol(o.Protocol) {
+			opts := []metrichttpexp.Option{
+				metrichttpexp.WithEndpoint(expandEnv(o.Endpoint)),
+				metrichttpexp.WithHeaders(expandEnvMap(o.Headers)),
+			}
+			if o.Insecure != nil && *o.Insecure {
+				opts = append(opts, metrichttpexp.WithInsecure())
+			}
+			if o.Timeout != nil {
+				opts = append(opts, metrichttpexp.WithTimeout(millis(o.Timeout, 0)))
+			}
+			return metrichttpexp.New(ctx, opts...)
+		}
+		opts := []metricexp.Option{
+			metricexp.WithEndpoint(expandEnv(o.Endpoint)),
+			metricexp.WithHeaders(expandEnvMap(o.Headers)),
+		}
+		if o.Insecure != nil && *o.Insecure {
+			opts = append(opts, metricexp.WithInsecure())
+		}
+		if o.Timeout != nil {
+			opts = append(opts, metricexp.WithTimeout(millis(o.Timeout, 0)))
+		}
+		return metricexp.New(ctx, opts...)
+	case ec.Console != nil:
+		return stdoutmetric.New()
+	default:
+		return nil, fmt.Errorf("exporter: no otlp or console configured")
+	}
+}
+
+func isHTTPProtocol(p string) bool {
+	p = expandEnv(p)
+	return p == "http/protobuf" || p == "http"
+}
+
+func expandEnvMap(m map[string]string) map[string]string {
+	if m == nil {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		out[k] = expandEnv(v)
+	}
+	return out
+}
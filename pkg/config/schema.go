@@ -0,0 +1,67 @@
+package config
+
+// This file models the subset of the OpenTelemetry declarative
+// configuration schema (file_format "0.3") that NewSDK understands:
+// resource, logger_provider, tracer_provider, meter_provider, and their
+// processors/readers/exporters. See
+// https://github.com/open-telemetry/opentelemetry-configuration.
+//
+// Every string field is passed through expandEnv before use, so
+// "${OTEL_EXPORTER_OTLP_ENDPOINT}"-style references work anywhere in the
+// document.
+
+type fileConfig struct {
+	FileFormat     string          `yaml:"file_format" json:"file_format"`
+	Resource       *resourceConfig `yaml:"resource" json:"resource"`
+	LoggerProvider *providerConfig `yaml:"logger_provider" json:"logger_provider"`
+	TracerProvider *providerConfig `yaml:"tracer_provider" json:"tracer_provider"`
+	MeterProvider  *providerConfig `yaml:"meter_provider" json:"meter_provider"`
+}
+
+type resourceConfig struct {
+	Attributes map[string]string `yaml:"attributes" json:"attributes"`
+}
+
+// providerConfig covers logger_provider/tracer_provider (processors) and
+// meter_provider (readers); only the field matching the provider is set.
+type providerConfig struct {
+	Processors []processorConfig `yaml:"processors" json:"processors"`
+	Readers    []readerConfig    `yaml:"readers" json:"readers"`
+}
+
+type processorConfig struct {
+	Batch  *batchProcessorConfig  `yaml:"batch" json:"batch"`
+	Simple *simpleProcessorConfig `yaml:"simple" json:"simple"`
+}
+
+type batchProcessorConfig struct {
+	Exporter            exporterConfig `yaml:"exporter" json:"exporter"`
+	ScheduleDelayMillis *int64         `yaml:"schedule_delay" json:"schedule_delay"`
+	MaxQueueSize        *int           `yaml:"max_queue_size" json:"max_queue_size"`
+}
+
+type simpleProcessorConfig struct {
+	Exporter exporterConfig `yaml:"exporter" json:"exporter"`
+}
+
+type readerConfig struct {
+	Periodic *periodicReaderConfig `yaml:"periodic" json:"periodic"`
+}
+
+type periodicReaderConfig struct {
+	IntervalMillis *int64         `yaml:"interval" json:"interval"`
+	Exporter       exporterConfig `yaml:"exporter" json:"exporter"`
+}
+
+type exporterConfig struct {
+	OTLP    *otlpExporterConfig    `yaml:"otlp" json:"otlp"`
+	Console map[string]interface{} `yaml:"console" json:"console"`
+}
+
+type otlpExporterConfig struct {
+	Protocol string            `yaml:"protocol" json:"protocol"` // "grpc" | "http/protobuf"
+	Endpoint string            `yaml:"endpoint" json:"endpoint"`
+	Insecure *bool             `yaml:"insecure" json:"insecure"`
+	Headers  map[string]string `yaml:"headers" json:"headers"`
+	Timeout  *int64            `yaml:"timeout" json:"timeout"` // milliseconds
+}
@@ -0,0 +1,127 @@
+package logger
+
+import (
+	"context"
+	"time"
+
+	otellog "go.opentelemetry.io/otel/log"
+	logglobal "go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ctxKey struct{}
+
+// Logger carries a component name and a set of baseline attributes applied
+// to every record it emits, alongside automatic trace correlation pulled
+// from the context passed to Emit. Obtain one via FromContext; derive a new
+// one with additional attributes via With.
+type Logger struct {
+	name  string
+	attrs []otellog.KeyValue
+}
+
+// defaultLoggerName is used by FromContext when no logger has been stored
+// in the context yet.
+const defaultLoggerName = "app"
+
+// FromContext returns the Logger stored in ctx by a previous call to With,
+// or a default Logger (component "app") if none is present.
+func FromContext(ctx context.Context) *Logger {
+	if l, ok := ctx.Value(ctxKey{}).(*Logger); ok {
+		return l
+	}
+	return &Logger{name: defaultLoggerName}
+}
+
+// With returns a context whose Logger inherits attrs in addition to
+// whatever the current logger (FromContext(ctx)) already carries, analogous
+// to slog.Logger.With.
+func With(ctx context.Context, attrs ...otellog.KeyValue) context.Context {
+	cur := FromContext(ctx)
+	next := &Logger{
+		name:  cur.name,
+		attrs: append(append([]otellog.KeyValue{}, cur.attrs...), attrs...),
+	}
+	return context.WithValue(ctx, ctxKey{}, next)
+}
+
+// Named returns a context whose Logger emits through the named OTel
+// component logger (see Logger package func), keeping any attributes
+// already attached via With.
+func Named(ctx context.Context, name string) context.Context {
+	cur := FromContext(ctx)
+	next := &Logger{name: name, attrs: cur.attrs}
+	return context.WithValue(ctx, ctxKey{}, next)
+}
+
+// Emit builds a record from msg/attrs plus the Logger's baseline
+// attributes, injects trace_id/span_id/trace_flags from ctx's active span,
+// and emits it through the named component logger.
+func (l *Logger) Emit(ctx context.Context, severity otellog.Severity, msg string, attrs ...otellog.KeyValue) {
+	all := append(append([]otellog.KeyValue{}, l.attrs...), attrs...)
+	r := newRecord(severity, msg, all...)
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttributes(
+			otellog.String("trace_id", sc.TraceID().String()),
+			otellog.String("span_id", sc.SpanID().String()),
+			otellog.Int64("trace_flags", int64(sc.TraceFlags())),
+		)
+	}
+	logglobal.GetLoggerProvider().Logger(l.name).Emit(ctx, r)
+}
+
+func (l *Logger) Debug(ctx context.Context, msg string, attrs ...otellog.KeyValue) {
+	l.Emit(ctx, otellog.SeverityDebug, msg, attrs...)
+}
+
+func (l *Logger) Info(ctx context.Context, msg string, attrs ...otellog.KeyValue) {
+	l.Emit(ctx, otellog.SeverityInfo, msg, attrs...)
+}
+
+func (l *Logger) Warn(ctx context.Context, msg string, attrs ...otellog.KeyValue) {
+	l.Emit(ctx, otellog.SeverityWarn, msg, attrs...)
+}
+
+func (l *Logger) Error(ctx context.Context, msg string, attrs ...otellog.KeyValue) {
+	l.Emit(ctx, otellog.SeverityError, msg, attrs...)
+}
+
+// RecordOption customizes a record built by NewRecord.
+type RecordOption func(*otellog.Record)
+
+// WithTimestamp overrides the record's timestamp (defaults to time.Now()).
+func WithTimestamp(t time.Time) RecordOption {
+	return func(r *otellog.Record) { r.SetTimestamp(t) }
+}
+
+// WithObservedTimestamp sets when the record was observed by the
+// collection pipeline, if different from when it occurred.
+func WithObservedTimestamp(t time.Time) RecordOption {
+	return func(r *otellog.Record) { r.SetObservedTimestamp(t) }
+}
+
+// WithSeverityText sets a human-readable severity string alongside the
+// numeric severity (e.g. the originating framework's own level name).
+func WithSeverityText(s string) RecordOption {
+	return func(r *otellog.Record) { r.SetSeverityText(s) }
+}
+
+// WithAttrs adds extra attributes to the record.
+func WithAttrs(attrs ...otellog.KeyValue) RecordOption {
+	return func(r *otellog.Record) {
+		for _, a := range attrs {
+			r.AddAttributes(a)
+		}
+	}
+}
+
+// NewRecord builds an otellog.Record for callers that need to set a custom
+// timestamp or severity number rather than using the Info/Warn/Error/Debug
+// sugar. It shares the same construction path as those helpers.
+func NewRecord(severity otellog.Severity, msg string, opts ...RecordOption) otellog.Record {
+	r := newRecord(severity, msg)
+	for _, opt := range opts {
+		opt(&r)
+	}
+	return r
+}
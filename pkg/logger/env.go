@@ -0,0 +1,171 @@
+package logger
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func firstNonEmpty(vals ...string) string {
+	for _, v := range vals {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
+
+// applyLogsEnv fills in zero-value Config fields from the
+// OTEL_EXPORTER_OTLP_LOGS_* env vars, falling back to the generic
+// OTEL_EXPORTER_OTLP_* vars, matching the precedence used by the upstream
+// OTLP exporters. Explicitly-set Config fields always win.
+func applyLogsEnv(cfg *Config) error {
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_ENDPOINT"),
+			os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
+		)
+	}
+	if cfg.Endpoint == "" {
+		cfg.Endpoint = "localhost:4317"
+	}
+
+	if cfg.Protocol == "" {
+		cfg.Protocol = firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_PROTOCOL"),
+			os.Getenv("OTEL_EXPORTER_OTLP_PROTOCOL"),
+			"grpc",
+		)
+	}
+
+	// A URL scheme on the endpoint implies transport security, matching the
+	// upstream OTLP exporters: http:// => insecure, https:// => TLS.
+	if scheme, rest, ok := splitEndpointScheme(cfg.Endpoint); ok {
+		cfg.Endpoint = rest
+		switch scheme {
+		case "http":
+			if cfg.Insecure == nil {
+				cfg.Insecure = boolPtr(true)
+			}
+		case "https":
+			if cfg.TLS == nil {
+				cfg.TLS = &tls.Config{}
+			}
+		}
+	}
+
+	if cfg.Insecure == nil {
+		if v := firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_INSECURE"),
+			os.Getenv("OTEL_EXPORTER_OTLP_INSECURE"),
+		); v != "" {
+			cfg.Insecure = boolPtr(v == "true")
+		}
+	}
+
+	if cfg.Headers == nil {
+		if h := firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_HEADERS"),
+			os.Getenv("OTEL_EXPORTER_OTLP_HEADERS"),
+		); h != "" {
+			cfg.Headers = parseHeaders(h)
+		}
+	}
+
+	if cfg.Compression == "" {
+		cfg.Compression = firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_COMPRESSION"),
+			os.Getenv("OTEL_EXPORTER_OTLP_COMPRESSION"),
+		)
+	}
+
+	if cfg.ExportTimeout == 0 {
+		if ms := firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_TIMEOUT"),
+			os.Getenv("OTEL_EXPORTER_OTLP_TIMEOUT"),
+		); ms != "" {
+			if n, err := strconv.Atoi(ms); err == nil {
+				cfg.ExportTimeout = time.Duration(n) * time.Millisecond
+			}
+		}
+	}
+
+	if cfg.TLS == nil {
+		ca := firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE"),
+			os.Getenv("OTEL_EXPORTER_OTLP_CERTIFICATE"),
+		)
+		clientCert := firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_CLIENT_CERTIFICATE"),
+			os.Getenv("OTEL_EXPORTER_OTLP_CLIENT_CERTIFICATE"),
+		)
+		clientKey := firstNonEmpty(
+			os.Getenv("OTEL_EXPORTER_OTLP_LOGS_CLIENT_KEY"),
+			os.Getenv("OTEL_EXPORTER_OTLP_CLIENT_KEY"),
+		)
+		if ca != "" || clientCert != "" {
+			tlsCfg, err := buildTLSConfig(ca, clientCert, clientKey)
+			if err != nil {
+				return fmt.Errorf("tls config: %w", err)
+			}
+			cfg.TLS = tlsCfg
+		}
+	}
+
+	return nil
+}
+
+// splitEndpointScheme strips a recognized http(s):// scheme off endpoint,
+// returning the bare host:port the gRPC/HTTP clients expect.
+func splitEndpointScheme(endpoint string) (scheme, rest string, ok bool) {
+	u, err := url.Parse(endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return "", endpoint, false
+	}
+	return u.Scheme, u.Host, true
+}
+
+// parseHeaders parses the W3C Baggage-style "k1=v1,k2=v2" format used by
+// OTEL_EXPORTER_OTLP_*_HEADERS.
+func parseHeaders(s string) map[string]string {
+	out := map[string]string{}
+	for _, pair := range strings.Split(s, ",") {
+		kv := strings.SplitN(strings.TrimSpace(pair), "=", 2)
+		if len(kv) == 2 {
+			out[strings.TrimSpace(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+	return out
+}
+
+func buildTLSConfig(caPath, certPath, keyPath string) (*tls.Config, error) {
+	tlsCfg := &tls.Config{}
+	if caPath != "" {
+		pem, err := os.ReadFile(caPath)
+		if err != nil {
+			return nil, fmt.Errorf("read ca certificate: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, fmt.Errorf("no certificates found in %s", caPath)
+		}
+		tlsCfg.RootCAs = pool
+	}
+	if certPath != "" && keyPath != "" {
+		cert, err := tls.LoadX509KeyPair(certPath, keyPath)
+		if err != nil {
+			return nil, fmt.Errorf("load client keypair: %w", err)
+		}
+		tlsCfg.Certificates = []tls.Certificate{cert}
+	}
+	return tlsCfg, nil
+}
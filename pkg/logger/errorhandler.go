@@ -0,0 +1,13 @@
+package logger
+
+import (
+	"go.opentelemetry.io/otel"
+)
+
+// SetErrorHandler installs fn as the global OTel error handler, so
+// exporter/queue failures, connection errors, and partial-success
+// responses that would otherwise be silently dropped are observable.
+// Call it once at startup, before or after InitLogs.
+func SetErrorHandler(fn func(error)) {
+	otel.SetErrorHandler(otel.ErrorHandlerFunc(fn))
+}
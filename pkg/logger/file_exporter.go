@@ -0,0 +1,289 @@
+package logger
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// FileExport configures a rotating, newline-delimited OTLP-JSON log sink,
+// suitable for an OTel collector's filelog receiver to tail. Set it on
+// Config to have InitLogs add a file processor alongside the OTLP
+// exporter; the OTLP exporter is still created, so the file sink does not
+// replace it.
+type FileExport struct {
+	// Path is the destination file. Required.
+	Path string
+	// MaxSizeBytes is the size threshold that triggers rotation before the
+	// next write would exceed it. 0 disables rotation.
+	MaxSizeBytes int64
+	// MaxBackups caps how many rotated files (Path.1, Path.2, ...) are kept;
+	// older ones are removed. 0 keeps all of them.
+	MaxBackups int
+}
+
+// fileExporter is an sdklog.Exporter that appends one OTLP-JSON LogRecord
+// per line to a rotating file.
+type fileExporter struct {
+	mu         sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	size       int64
+
+	f *os.File
+	w *bufio.Writer
+
+	resourcePB *resourcepb.Resource
+
+	flushInterval time.Duration
+	stopOnce      sync.Once
+	stopCh        chan struct{}
+	doneCh        chan struct{}
+}
+
+func newFileExporter(cfg FileExport, res *resource.Resource, flushInterval time.Duration) (*fileExporter, error) {
+	if cfg.Path == "" {
+		return nil, fmt.Errorf("file log exporter: Path is required")
+	}
+	if flushInterval <= 0 {
+		flushInterval = 2 * time.Second
+	}
+
+	if dir := filepath.Dir(cfg.Path); dir != "." {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			return nil, fmt.Errorf("file log exporter: %w", err)
+		}
+	}
+
+	e := &fileExporter{
+		path:          cfg.Path,
+		maxSize:       cfg.MaxSizeBytes,
+		maxBackups:    cfg.MaxBackups,
+		resourcePB:    resourceToPB(res),
+		flushInterval: flushInterval,
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+	if err := e.openLocked(); err != nil {
+		return nil, err
+	}
+
+	go e.flushLoop()
+
+	return e, nil
+}
+
+func (e *fileExporter) openLocked() error {
+	f, err := os.OpenFile(e.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return fmt.Errorf("file log exporter: open %s: %w", e.path, err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		f.Close()
+		return fmt.Errorf("file log exporter: stat %s: %w", e.path, err)
+	}
+	e.f = f
+	e.w = bufio.NewWriter(f)
+	e.size = info.Size()
+	return nil
+}
+
+func (e *fileExporter) flushLoop() {
+	defer close(e.doneCh)
+	t := time.NewTicker(e.flushInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-t.C:
+			e.mu.Lock()
+			_ = e.w.Flush()
+			e.mu.Unlock()
+		case <-e.stopCh:
+			return
+		}
+	}
+}
+
+// Export writes each record as a single line of OTLP-JSON, wrapped in a
+// ResourceLogs/ScopeLogs envelope, rotating the file first if needed.
+func (e *fileExporter) Export(ctx context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for i := range records {
+		line, err := e.encodeLocked(&records[i])
+		if err != nil {
+			return fmt.Errorf("file log exporter: encode: %w", err)
+		}
+		if e.maxSize > 0 && e.size+int64(len(line))+1 > e.maxSize {
+			if err := e.rotateLocked(); err != nil {
+				return err
+			}
+		}
+		n, err := e.w.Write(line)
+		if err == nil {
+			err = e.w.WriteByte('\n')
+			n++
+		}
+		if err != nil {
+			return fmt.Errorf("file log exporter: write: %w", err)
+		}
+		e.size += int64(n)
+	}
+	return nil
+}
+
+func (e *fileExporter) encodeLocked(r *sdklog.Record) ([]byte, error) {
+	rl := &logspb.ResourceLogs{
+		Resource: e.resourcePB,
+		ScopeLogs: []*logspb.ScopeLogs{
+			{
+				Scope: &commonpb.InstrumentationScope{Name: r.InstrumentationScope().Name},
+				LogRecords: []*logspb.LogRecord{
+					recordToPB(r),
+				},
+			},
+		},
+	}
+	return protojson.Marshal(&logspb.LogsData{ResourceLogs: []*logspb.ResourceLogs{rl}})
+}
+
+// rotateLocked renames the current file to path.1 (shifting older backups
+// up by one, dropping anything past MaxBackups) and opens a fresh file.
+func (e *fileExporter) rotateLocked() error {
+	_ = e.w.Flush()
+	_ = e.f.Close()
+
+	if e.maxBackups > 0 {
+		oldest := fmt.Sprintf("%s.%d", e.path, e.maxBackups)
+		_ = os.Remove(oldest)
+		for i := e.maxBackups - 1; i >= 1; i-- {
+			src := fmt.Sprintf("%s.%d", e.path, i)
+			dst := fmt.Sprintf("%s.%d", e.path, i+1)
+			_ = os.Rename(src, dst)
+		}
+		_ = os.Rename(e.path, fmt.Sprintf("%s.1", e.path))
+	} else {
+		n := 1
+		for {
+			if _, err := os.Stat(fmt.Sprintf("%s.%d", e.path, n)); err != nil {
+				break
+			}
+			n++
+		}
+		for i := n - 1; i >= 1; i-- {
+			_ = os.Rename(fmt.Sprintf("%s.%d", e.path, i), fmt.Sprintf("%s.%d", e.path, i+1))
+		}
+		_ = os.Rename(e.path, e.path+".1")
+	}
+
+	return e.openLocked()
+}
+
+func (e *fileExporter) Shutdown(ctx context.Context) error {
+	e.stopOnce.Do(func() { close(e.stopCh) })
+	<-e.doneCh
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if err := e.w.Flush(); err != nil {
+		return fmt.Errorf("file log exporter: flush: %w", err)
+	}
+	return e.f.Close()
+}
+
+func (e *fileExporter) ForceFlush(ctx context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.w.Flush()
+}
+
+func resourceToPB(res *resource.Resource) *resourcepb.Resource {
+	if res == nil {
+		return &resourcepb.Resource{}
+	}
+	it := res.Iter()
+	attrs := make([]*commonpb.KeyValue, 0, it.Len())
+	for it.Next() {
+		attrs = append(attrs, keyValueToPB(it.Attribute()))
+	}
+	return &resourcepb.Resource{Attributes: attrs}
+}
+
+func recordToPB(r *sdklog.Record) *logspb.LogRecord {
+	pb := &logspb.LogRecord{
+		TimeUnixNano:         uint64(r.Timestamp().UnixNano()),
+		ObservedTimeUnixNano: uint64(r.ObservedTimestamp().UnixNano()),
+		SeverityNumber:       logspb.SeverityNumber(r.Severity()),
+		SeverityText:         r.SeverityText(),
+		Body:                 valueToPB(r.Body()),
+	}
+	if tid := r.TraceID(); tid.IsValid() {
+		pb.TraceId = tid[:]
+	}
+	if sid := r.SpanID(); sid.IsValid() {
+		pb.SpanId = sid[:]
+	}
+	pb.Flags = uint32(r.TraceFlags())
+
+	r.WalkAttributes(func(kv otellog.KeyValue) bool {
+		pb.Attributes = append(pb.Attributes, &commonpb.KeyValue{
+			Key:   kv.Key,
+			Value: valueToPB(kv.Value),
+		})
+		return true
+	})
+
+	return pb
+}
+
+func keyValueToPB(kv otellog.KeyValue) *commonpb.KeyValue {
+	return &commonpb.KeyValue{Key: kv.Key, Value: valueToPB(kv.Value)}
+}
+
+func valueToPB(v otellog.Value) *commonpb.AnyValue {
+	switch v.Kind() {
+	case otellog.KindBool:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BoolValue{BoolValue: v.AsBool()}}
+	case otellog.KindInt64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: v.AsInt64()}}
+	case otellog.KindFloat64:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_DoubleValue{DoubleValue: v.AsFloat64()}}
+	case otellog.KindString:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.AsString()}}
+	case otellog.KindBytes:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_BytesValue{BytesValue: v.AsBytes()}}
+	case otellog.KindSlice:
+		vals := v.AsSlice()
+		arr := &commonpb.ArrayValue{Values: make([]*commonpb.AnyValue, len(vals))}
+		for i, e := range vals {
+			arr.Values[i] = valueToPB(e)
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_ArrayValue{ArrayValue: arr}}
+	case otellog.KindMap:
+		kvs := v.AsMap()
+		kvl := &commonpb.KeyValueList{Values: make([]*commonpb.KeyValue, len(kvs))}
+		for i, e := range kvs {
+			kvl.Values[i] = keyValueToPB(e)
+		}
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_KvlistValue{KvlistValue: kvl}}
+	default:
+		return &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: v.String()}}
+	}
+}
@@ -0,0 +1,63 @@
+package logger
+
+import (
+	"context"
+	"strings"
+
+	otellog "go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// levelFilterProcessor drops records below a minimum severity before they
+// reach the wrapped processor, so filtered-out records never hit the batch
+// queue (and never cost an export).
+type levelFilterProcessor struct {
+	next sdklog.Processor
+	min  otellog.Severity
+}
+
+var _ sdklog.Processor = (*levelFilterProcessor)(nil)
+
+func newLevelFilterProcessor(next sdklog.Processor, min otellog.Severity) sdklog.Processor {
+	if min <= otellog.SeverityUndefined {
+		return next
+	}
+	return &levelFilterProcessor{next: next, min: min}
+}
+
+func (p *levelFilterProcessor) OnEmit(ctx context.Context, r *sdklog.Record) error {
+	if r.Severity() < p.min {
+		return nil
+	}
+	return p.next.OnEmit(ctx, r)
+}
+
+func (p *levelFilterProcessor) Shutdown(ctx context.Context) error {
+	return p.next.Shutdown(ctx)
+}
+
+func (p *levelFilterProcessor) ForceFlush(ctx context.Context) error {
+	return p.next.ForceFlush(ctx)
+}
+
+// severityFromString maps Config.LogLevel ("debug"|"info"|"warn"|"error"|
+// "fatal"|"panic") to the minimum otellog.Severity to keep. An empty or
+// unrecognized level returns SeverityUndefined, meaning "don't filter".
+func severityFromString(level string) otellog.Severity {
+	switch strings.ToLower(level) {
+	case "debug":
+		return otellog.SeverityDebug
+	case "info":
+		return otellog.SeverityInfo
+	case "warn", "warning":
+		return otellog.SeverityWarn
+	case "error":
+		return otellog.SeverityError
+	case "fatal":
+		return otellog.SeverityFatal
+	case "panic":
+		return otellog.SeverityFatal4
+	default:
+		return otellog.SeverityUndefined
+	}
+}
@@ -2,30 +2,28 @@ package logger
 
 import (
 	"context"
+	"crypto/tls"
 	"errors"
 	"fmt"
-	"os"
 	"time"
 
 	"go.opentelemetry.io/otel/attribute"
-	logexp "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
 	"go.opentelemetry.io/otel/exporters/stdout/stdoutlog"
 	otellog "go.opentelemetry.io/otel/log"
 	logglobal "go.opentelemetry.io/otel/log/global"
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 	"go.opentelemetry.io/otel/sdk/resource"
 	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
-
-	"google.golang.org/grpc"
-	"google.golang.org/grpc/backoff"
-	"google.golang.org/grpc/credentials/insecure"
 )
 
 type Config struct {
 	// OTLP/collector address, e.g. "otel-collector:4317". If empty, uses OTEL_EXPORTER_OTLP_ENDPOINT or "localhost:4317".
 	Endpoint string
-	// If true, uses plaintext (inside cluster / local). If false, use TLS (you'll need creds).
-	Insecure bool
+	// If true, uses plaintext (inside cluster / local). If false, use TLS
+	// (you'll need creds). Nil defers to the endpoint scheme or the
+	// OTEL_EXPORTER_OTLP_(LOGS_)INSECURE env vars; an explicit value here
+	// always wins over both.
+	Insecure *bool
 
 	ServiceName string // required
 	ServiceVer  string // optional
@@ -39,6 +37,59 @@ type Config struct {
 	DialTimeout    time.Duration // default 10s
 	ExportInterval time.Duration // default 2s
 	MaxQueueSize   int           // default 4096
+
+	// Resource lets callers share a single resource.Resource across signals
+	// (e.g. from observability.Init). If nil, one is built from cfg + env.
+	Resource *resource.Resource
+
+	// FileExport, if set, adds a rotating OTLP-JSON file processor alongside
+	// the gRPC exporter, for offline/sidecar collection (e.g. a collector's
+	// filelog receiver tailing the file).
+	FileExport *FileExport
+
+	// Protocol selects the OTLP transport: "grpc" (default) or
+	// "http/protobuf". Falls back to OTEL_EXPORTER_OTLP_LOGS_PROTOCOL /
+	// OTEL_EXPORTER_OTLP_PROTOCOL.
+	Protocol string
+	// Headers are added to every export request, e.g. for collector auth.
+	// Falls back to OTEL_EXPORTER_OTLP_LOGS_HEADERS / OTEL_EXPORTER_OTLP_HEADERS.
+	Headers map[string]string
+	// Compression is "gzip" or "none" (default). Falls back to
+	// OTEL_EXPORTER_OTLP_LOGS_COMPRESSION / OTEL_EXPORTER_OTLP_COMPRESSION.
+	Compression string
+	// ExportTimeout bounds a single export call. Falls back to
+	// OTEL_EXPORTER_OTLP_LOGS_TIMEOUT / OTEL_EXPORTER_OTLP_TIMEOUT (ms).
+	ExportTimeout time.Duration
+	// TLS configures the client certificate/CA used to reach the collector.
+	// An https:// Endpoint scheme or the
+	// OTEL_EXPORTER_OTLP_LOGS_CERTIFICATE/CLIENT_KEY/CLIENT_CERTIFICATE env
+	// vars populate this automatically if left nil.
+	TLS *tls.Config
+
+	// Retry configures the OTLP exporter's built-in retry behavior for
+	// retryable gRPC status codes (UNAVAILABLE, RESOURCE_EXHAUSTED honoring
+	// RetryInfo.retry_delay, DEADLINE_EXCEEDED). Disabled by default.
+	Retry RetryConfig
+
+	// QueueFullPolicy controls what happens when the OTLP processor's queue
+	// fills up. Default is QueueFullPolicyDropNewest.
+	QueueFullPolicy QueueFullPolicy
+	// QueueFullTimeout bounds how long a blocking enqueue waits under
+	// QueueFullPolicyBlock before falling back to dropping. Default 1s.
+	QueueFullTimeout time.Duration
+	// OnQueueMetrics, if set, is called with a running snapshot of
+	// enqueued/dropped/exported counts; wire it up to a meter.Meter
+	// observable gauge to publish queue health as a metric.
+	OnQueueMetrics QueueMetricsFunc
+}
+
+// RetryConfig mirrors otlploggrpc.RetryConfig; see that package for exact
+// backoff semantics.
+type RetryConfig struct {
+	Enabled         bool
+	InitialInterval time.Duration // default 5s
+	MaxInterval     time.Duration // default 30s
+	MaxElapsedTime  time.Duration // default 1m
 }
 
 // InitLogs initializes a global OTel LoggerProvider. Call once at startup.
@@ -48,12 +99,11 @@ func InitLogs(ctx context.Context, cfg Config) (func(context.Context) error, err
 		return nil, errors.New("telemetry: ServiceName is required")
 	}
 
-	if cfg.Endpoint == "" {
-		// Also respects the standard env var if you forgot to pass Endpoint.
-		cfg.Endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
-		if cfg.Endpoint == "" {
-			cfg.Endpoint = "localhost:4317"
-		}
+	// Fills in Endpoint/Protocol/Headers/Compression/TLS/ExportTimeout from
+	// OTEL_EXPORTER_OTLP_LOGS_* (falling back to OTEL_EXPORTER_OTLP_*) for
+	// whatever wasn't set explicitly.
+	if err := applyLogsEnv(&cfg); err != nil {
+		return nil, err
 	}
 	if cfg.DialTimeout == 0 {
 		cfg.DialTimeout = 10 * time.Second
@@ -66,54 +116,52 @@ func InitLogs(ctx context.Context, cfg Config) (func(context.Context) error, err
 	}
 
 	// Build a shared resource. Merges OTEL_RESOURCE_ATTRIBUTES automatically.
-	res, err := resource.New(ctx,
-		resource.WithFromEnv(),
-		resource.WithHost(),
-		resource.WithTelemetrySDK(),
-		resource.WithAttributes(
-			semconv.ServiceName(cfg.ServiceName),
-			semconv.ServiceVersion(cfg.ServiceVer),
-			attribute.String("deployment.environment", cfg.Environment),
-			attribute.String("deployment.log_level", cfg.LogLevel),
-		),
-	)
-	if err != nil {
-		return nil, fmt.Errorf("resource: %w", err)
-	}
-
-	bo := backoff.Config{
-		BaseDelay:  500 * time.Millisecond,
-		Multiplier: 1.6,
-		MaxDelay:   5 * time.Second,
-	}
-	dialOpts := []grpc.DialOption{
-		grpc.WithBlock(),
-		grpc.WithConnectParams(grpc.ConnectParams{
-			Backoff:           bo,
-			MinConnectTimeout: cfg.DialTimeout,
-		}),
-	}
-	if cfg.Insecure {
-		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	res := cfg.Resource
+	if res == nil {
+		var err error
+		res, err = resource.New(ctx,
+			resource.WithFromEnv(),
+			resource.WithHost(),
+			resource.WithTelemetrySDK(),
+			resource.WithAttributes(
+				semconv.ServiceName(cfg.ServiceName),
+				semconv.ServiceVersion(cfg.ServiceVer),
+				attribute.String("deployment.environment", cfg.Environment),
+				attribute.String("deployment.log_level", cfg.LogLevel),
+			),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("resource: %w", err)
+		}
 	}
 
-	// Create OTLP exporter
-	otlpExp, err := logexp.New(ctx,
-		logexp.WithEndpoint(cfg.Endpoint),
-		logexp.WithDialOption(dialOpts...),
-	)
+	// Create OTLP exporter (gRPC or HTTP, per cfg.Protocol)
+	otlpExp, err := newLogsExporter(ctx, cfg)
 	if err != nil {
-		return nil, fmt.Errorf("otlp log exporter: %w", err)
+		return nil, err
 	}
 
-	// Create batch processor for OTLP
-	otlpProcessor := sdklog.NewBatchProcessor(otlpExp,
-		sdklog.WithExportInterval(cfg.ExportInterval),
-		sdklog.WithMaxQueueSize(cfg.MaxQueueSize),
+	// Drive the OTLP exporter from our own bounded queue (rather than
+	// sdklog.NewBatchProcessor's) so queue overflow follows
+	// Config.QueueFullPolicy and is observable via Config.OnQueueMetrics.
+	otlpProcessor := newQueuedProcessor(
+		otlpExp, cfg.MaxQueueSize, cfg.QueueFullPolicy, cfg.QueueFullTimeout, cfg.ExportInterval, cfg.OnQueueMetrics,
 	)
 
 	processors := []sdklog.Processor{otlpProcessor}
 
+	// Optionally add a rotating OTLP-JSON file sink
+	if cfg.FileExport != nil {
+		fileExp, err := newFileExporter(*cfg.FileExport, res, cfg.ExportInterval)
+		if err != nil {
+			return nil, fmt.Errorf("file log exporter: %w", err)
+		}
+		processors = append(processors, sdklog.NewBatchProcessor(fileExp,
+			sdklog.WithExportInterval(cfg.ExportInterval),
+			sdklog.WithMaxQueueSize(cfg.MaxQueueSize),
+		))
+	}
+
 	// Optionally add stdout exporter
 	if cfg.EnableStdout {
 		stdoutExp, err := stdoutlog.New(
@@ -128,12 +176,15 @@ func InitLogs(ctx context.Context, cfg Config) (func(context.Context) error, err
 		processors = append(processors, stdoutProcessor)
 	}
 
-	// Create logger provider with all processors
+	// Create logger provider with all processors, gating each on
+	// Config.LogLevel so records below the threshold never reach the batch
+	// queue.
+	minSeverity := severityFromString(cfg.LogLevel)
 	lpOptions := []sdklog.LoggerProviderOption{
 		sdklog.WithResource(res),
 	}
 	for _, processor := range processors {
-		lpOptions = append(lpOptions, sdklog.WithProcessor(processor))
+		lpOptions = append(lpOptions, sdklog.WithProcessor(newLevelFilterProcessor(processor, minSeverity)))
 	}
 
 	lp := sdklog.NewLoggerProvider(lpOptions...)
@@ -147,47 +198,22 @@ func Logger(name string) otellog.Logger {
 	return logglobal.GetLoggerProvider().Logger(name)
 }
 
-// Helper line-level emitters (optional sugar).
-func Info(ctx context.Context, l otellog.Logger, msg string, attrs ...otellog.KeyValue) {
-	var r otellog.Record
-	r.SetTimestamp(time.Now())
-	r.SetSeverity(otellog.SeverityInfo)
-	r.SetBody(otellog.StringValue(msg))
-	for _, a := range attrs {
-		r.AddAttributes(a)
-	}
-	l.Emit(ctx, r)
+// Helper line-level emitters (optional sugar). Each is a thin wrapper over
+// FromContext(ctx)/Logger.Emit, so there's a single emit path whether
+// callers reach for the package-level sugar or the Logger methods
+// directly.
+func Info(ctx context.Context, msg string, attrs ...otellog.KeyValue) {
+	FromContext(ctx).Info(ctx, msg, attrs...)
 }
 
-func Error(ctx context.Context, l otellog.Logger, msg string, attrs ...otellog.KeyValue) {
-	var r otellog.Record
-	r.SetTimestamp(time.Now())
-	r.SetSeverity(otellog.SeverityError)
-	r.SetBody(otellog.StringValue(msg))
-	for _, a := range attrs {
-		r.AddAttributes(a)
-	}
-	l.Emit(ctx, r)
+func Error(ctx context.Context, msg string, attrs ...otellog.KeyValue) {
+	FromContext(ctx).Error(ctx, msg, attrs...)
 }
 
-func Warn(ctx context.Context, l otellog.Logger, msg string, attrs ...otellog.KeyValue) {
-	var r otellog.Record
-	r.SetTimestamp(time.Now())
-	r.SetSeverity(otellog.SeverityWarn)
-	r.SetBody(otellog.StringValue(msg))
-	for _, a := range attrs {
-		r.AddAttributes(a)
-	}
-	l.Emit(ctx, r)
+func Warn(ctx context.Context, msg string, attrs ...otellog.KeyValue) {
+	FromContext(ctx).Warn(ctx, msg, attrs...)
 }
 
-func Debug(ctx context.Context, l otellog.Logger, msg string, attrs ...otellog.KeyValue) {
-	var r otellog.Record
-	r.SetTimestamp(time.Now())
-	r.SetSeverity(otellog.SeverityDebug)
-	r.SetBody(otellog.StringValue(msg))
-	for _, a := range attrs {
-		r.AddAttributes(a)
-	}
-	l.Emit(ctx, r)
+func Debug(ctx context.Context, msg string, attrs ...otellog.KeyValue) {
+	FromContext(ctx).Debug(ctx, msg, attrs...)
 }
@@ -0,0 +1,72 @@
+// Package logrusbridge adapts github.com/sirupsen/logrus entries into the
+// global OTel LoggerProvider, so apps already using logrus can adopt this
+// module without rewriting call sites.
+package logrusbridge
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+
+	otellog "go.opentelemetry.io/otel/log"
+	logglobal "go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Hook is a logrus.Hook that mirrors every fired entry to the named OTel
+// component logger (see logger.Logger). Attach it with
+// log.AddHook(logrusbridge.NewHook("http")).
+type Hook struct {
+	logger otellog.Logger
+}
+
+var _ logrus.Hook = (*Hook)(nil)
+
+// NewHook returns a Hook that emits through the named OTel component
+// logger.
+func NewHook(name string) *Hook {
+	return &Hook{logger: logglobal.GetLoggerProvider().Logger(name)}
+}
+
+// Levels reports that this hook fires for every logrus level.
+func (h *Hook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+// Fire implements logrus.Hook.
+func (h *Hook) Fire(entry *logrus.Entry) error {
+	var r otellog.Record
+	r.SetTimestamp(entry.Time)
+	r.SetSeverity(logrusLevelToSeverity(entry.Level))
+	r.SetSeverityText(entry.Level.String())
+	r.SetBody(otellog.StringValue(entry.Message))
+
+	for k, v := range entry.Data {
+		r.AddAttributes(otellog.String(k, toString(v)))
+	}
+
+	ctx := entry.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttributes(
+			otellog.String("trace_id", sc.TraceID().String()),
+			otellog.String("span_id", sc.SpanID().String()),
+		)
+	}
+
+	h.logger.Emit(ctx, r)
+	return nil
+}
+
+func toString(v interface{}) string {
+	if s, ok := v.(string); ok {
+		return s
+	}
+	if err, ok := v.(error); ok {
+		return err.Error()
+	}
+	return fmt.Sprintf("%v", v)
+}
@@ -0,0 +1,207 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+)
+
+// QueueFullPolicy controls what happens when the OTLP processor's queue is
+// full and a new record arrives.
+type QueueFullPolicy int
+
+const (
+	// QueueFullPolicyDropNewest discards the incoming record immediately.
+	// This is the default: logging must never block the caller.
+	QueueFullPolicyDropNewest QueueFullPolicy = iota
+	// QueueFullPolicyBlock waits up to Config.QueueFullTimeout for room in
+	// the queue before falling back to dropping the record.
+	QueueFullPolicyBlock
+)
+
+// QueueMetrics is a point-in-time snapshot of queuedProcessor counters.
+type QueueMetrics struct {
+	Enqueued int64
+	Dropped  int64
+	Exported int64
+}
+
+// QueueMetricsFunc receives a QueueMetrics snapshot after every enqueue,
+// drop, or export. Set it via Config.OnQueueMetrics; keep it cheap, it runs
+// on the logging hot path. Pair with meter.Meter to publish these as
+// observable gauges once the meter provider is wired.
+type QueueMetricsFunc func(QueueMetrics)
+
+// queuedProcessor owns the only queue between OnEmit and the exporter: it
+// batches records itself and calls sdklog.Exporter.Export directly, rather
+// than stacking in front of sdklog.NewBatchProcessor (which has its own
+// bounded queue with no pluggable overflow policy, making a second queue in
+// front of it pointless — sustained load would overflow there instead,
+// invisibly). That makes QueueFullPolicy/QueueMetrics describe what
+// actually happens to a record.
+type queuedProcessor struct {
+	exporter sdklog.Exporter
+	ch       chan sdklog.Record
+	policy   QueueFullPolicy
+	timeout  time.Duration
+	interval time.Duration
+
+	flushReq chan chan error
+	stopCh   chan struct{}
+	done     chan struct{}
+
+	enqueued atomic.Int64
+	dropped  atomic.Int64
+	exported atomic.Int64
+	onMetric QueueMetricsFunc
+}
+
+var _ sdklog.Processor = (*queuedProcessor)(nil)
+
+func newQueuedProcessor(exporter sdklog.Exporter, size int, policy QueueFullPolicy, timeout, interval time.Duration, onMetric QueueMetricsFunc) *queuedProcessor {
+	if size <= 0 {
+		size = 4096
+	}
+	if timeout <= 0 {
+		timeout = time.Second
+	}
+	if interval <= 0 {
+		interval = 2 * time.Second
+	}
+	qp := &queuedProcessor{
+		exporter: exporter,
+		ch:       make(chan sdklog.Record, size),
+		policy:   policy,
+		timeout:  timeout,
+		interval: interval,
+		flushReq: make(chan chan error),
+		stopCh:   make(chan struct{}),
+		done:     make(chan struct{}),
+		onMetric: func(m QueueMetrics) {
+			if onMetric != nil {
+				onMetric(m)
+			}
+		},
+	}
+	go qp.run()
+	return qp
+}
+
+func (qp *queuedProcessor) run() {
+	defer close(qp.done)
+
+	ticker := time.NewTicker(qp.interval)
+	defer ticker.Stop()
+
+	var batch []sdklog.Record
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := qp.exporter.Export(context.Background(), batch); err != nil {
+			otel.Handle(fmt.Errorf("logger: export %d record(s): %w", len(batch), err))
+		} else {
+			qp.exported.Add(int64(len(batch)))
+		}
+		qp.snapshot()
+		batch = batch[:0]
+	}
+	drainNonBlocking := func() {
+		for {
+			select {
+			case r := <-qp.ch:
+				batch = append(batch, r)
+			default:
+				return
+			}
+		}
+	}
+
+	for {
+		select {
+		case r := <-qp.ch:
+			batch = append(batch, r)
+		case <-ticker.C:
+			export()
+		case resp := <-qp.flushReq:
+			drainNonBlocking()
+			export()
+			resp <- nil
+		case <-qp.stopCh:
+			drainNonBlocking()
+			export()
+			return
+		}
+	}
+}
+
+func (qp *queuedProcessor) snapshot() {
+	qp.onMetric(QueueMetrics{
+		Enqueued: qp.enqueued.Load(),
+		Dropped:  qp.dropped.Load(),
+		Exported: qp.exported.Load(),
+	})
+}
+
+func (qp *queuedProcessor) OnEmit(ctx context.Context, r *sdklog.Record) error {
+	rec := r.Clone()
+
+	select {
+	case qp.ch <- rec:
+		qp.enqueued.Add(1)
+		qp.snapshot()
+		return nil
+	default:
+	}
+
+	if qp.policy == QueueFullPolicyBlock {
+		t := time.NewTimer(qp.timeout)
+		defer t.Stop()
+		select {
+		case qp.ch <- rec:
+			qp.enqueued.Add(1)
+			qp.snapshot()
+			return nil
+		case <-t.C:
+		case <-ctx.Done():
+		}
+	}
+
+	qp.dropped.Add(1)
+	qp.snapshot()
+	otel.Handle(fmt.Errorf("logger: queue full (size %d), dropped record", cap(qp.ch)))
+	return nil
+}
+
+// ForceFlush drains whatever is currently queued and exports it before
+// delegating to the exporter's own ForceFlush.
+func (qp *queuedProcessor) ForceFlush(ctx context.Context) error {
+	resp := make(chan error, 1)
+	select {
+	case qp.flushReq <- resp:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	select {
+	case err := <-resp:
+		if err != nil {
+			return err
+		}
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return qp.exporter.ForceFlush(ctx)
+}
+
+func (qp *queuedProcessor) Shutdown(ctx context.Context) error {
+	close(qp.stopCh)
+	select {
+	case <-qp.done:
+	case <-ctx.Done():
+	}
+	return qp.exporter.Shutdown(ctx)
+}
@@ -0,0 +1,22 @@
+package logger
+
+import (
+	"time"
+
+	otellog "go.opentelemetry.io/otel/log"
+)
+
+// newRecord builds an otellog.Record with the given severity, body, and
+// attributes. It's the shared construction path used by the Info/Warn/
+// Error/Debug helpers and by the slog bridge, so every emission path
+// produces records the same way.
+func newRecord(severity otellog.Severity, msg string, attrs ...otellog.KeyValue) otellog.Record {
+	var r otellog.Record
+	r.SetTimestamp(time.Now())
+	r.SetSeverity(severity)
+	r.SetBody(otellog.StringValue(msg))
+	for _, a := range attrs {
+		r.AddAttributes(a)
+	}
+	return r
+}
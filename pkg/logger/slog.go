@@ -0,0 +1,161 @@
+package logger
+
+import (
+	"context"
+	"log/slog"
+
+	otellog "go.opentelemetry.io/otel/log"
+	logglobal "go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Option configures a slogHandler. The logrus and zerolog bridges in this
+// package's subpackages do not take Options.
+type Option func(*slogHandler)
+
+// WithSeverityOffset adds n to every mapped OTel severity number, e.g. an
+// offset of 4 moves slog Info records (SeverityInfo, 9) into the warn range
+// (SeverityWarn, 13). Most callers don't need this.
+func WithSeverityOffset(n otellog.Severity) Option {
+	return func(h *slogHandler) { h.severityOffset = n }
+}
+
+// slogHandler adapts slog.Record values into otellog.Record and emits them
+// via the global LoggerProvider, automatically correlating with the active
+// span in ctx.
+type slogHandler struct {
+	name           string
+	logger         otellog.Logger
+	attrs          []otellog.KeyValue
+	groups         []string
+	severityOffset otellog.Severity
+}
+
+var _ slog.Handler = (*slogHandler)(nil)
+
+// NewSlogHandler returns a slog.Handler that emits through the named OTel
+// component logger (see Logger). Install it with slog.New(...) /
+// slog.SetDefault(...) to route stdlib slog calls into this module.
+func NewSlogHandler(name string, opts ...Option) slog.Handler {
+	h := &slogHandler{
+		name:   name,
+		logger: logglobal.GetLoggerProvider().Logger(name),
+	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
+}
+
+func (h *slogHandler) Enabled(_ context.Context, _ slog.Level) bool {
+	// Level filtering is handled by Config.LogLevel via the processor chain
+	// installed in InitLogs; the handler itself passes everything through.
+	return true
+}
+
+func (h *slogHandler) Handle(ctx context.Context, rec slog.Record) error {
+	r := newRecord(slogLevelToSeverity(rec.Level)+h.severityOffset, rec.Message, h.attrs...)
+	r.SetTimestamp(rec.Time)
+	r.SetSeverityText(rec.Level.String())
+
+	rec.Attrs(func(a slog.Attr) bool {
+		if kv, ok := slogAttrToKeyValue(h.groups, a); ok {
+			r.AddAttributes(kv)
+		}
+		return true
+	})
+
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		r.AddAttributes(
+			otellog.String("trace_id", sc.TraceID().String()),
+			otellog.String("span_id", sc.SpanID().String()),
+			otellog.Int64("trace_flags", int64(sc.TraceFlags())),
+		)
+	}
+
+	h.logger.Emit(ctx, r)
+	return nil
+}
+
+func (h *slogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	next := *h
+	next.attrs = append(append([]otellog.KeyValue{}, h.attrs...), slogAttrsToKeyValues(h.groups, attrs)...)
+	return &next
+}
+
+func (h *slogHandler) WithGroup(name string) slog.Handler {
+	next := *h
+	next.groups = append(append([]string{}, h.groups...), name)
+	return &next
+}
+
+func slogLevelToSeverity(l slog.Level) otellog.Severity {
+	switch {
+	case l >= slog.LevelError:
+		return otellog.SeverityError
+	case l >= slog.LevelWarn:
+		return otellog.SeverityWarn
+	case l >= slog.LevelInfo:
+		return otellog.SeverityInfo
+	default:
+		return otellog.SeverityDebug
+	}
+}
+
+// slogAttrToKeyValue translates a single slog.Attr, namespacing its key
+// under groups (joined with ".") and recursing into slog.Group values.
+func slogAttrToKeyValue(groups []string, a slog.Attr) (otellog.KeyValue, bool) {
+	a.Value = a.Value.Resolve()
+	if a.Equal(slog.Attr{}) {
+		return otellog.KeyValue{}, false
+	}
+
+	key := a.Key
+	for i := len(groups) - 1; i >= 0; i-- {
+		key = groups[i] + "." + key
+	}
+
+	if a.Value.Kind() == slog.KindGroup {
+		members := a.Value.Group()
+		kvs := make([]otellog.KeyValue, 0, len(members))
+		for _, m := range members {
+			if kv, ok := slogAttrToKeyValue(nil, m); ok {
+				kvs = append(kvs, kv)
+			}
+		}
+		return otellog.Map(key, kvs...), true
+	}
+
+	return otellog.KeyValue{Key: key, Value: slogValueToValue(a.Value)}, true
+}
+
+func slogAttrsToKeyValues(groups []string, attrs []slog.Attr) []otellog.KeyValue {
+	out := make([]otellog.KeyValue, 0, len(attrs))
+	for _, a := range attrs {
+		if kv, ok := slogAttrToKeyValue(groups, a); ok {
+			out = append(out, kv)
+		}
+	}
+	return out
+}
+
+func slogValueToValue(v slog.Value) otellog.Value {
+	switch v.Kind() {
+	case slog.KindString:
+		return otellog.StringValue(v.String())
+	case slog.KindInt64:
+		return otellog.Int64Value(v.Int64())
+	case slog.KindUint64:
+		return otellog.Int64Value(int64(v.Uint64()))
+	case slog.KindFloat64:
+		return otellog.Float64Value(v.Float64())
+	case slog.KindBool:
+		return otellog.BoolValue(v.Bool())
+	case slog.KindDuration:
+		return otellog.StringValue(v.Duration().String())
+	case slog.KindTime:
+		return otellog.StringValue(v.Time().String())
+	default:
+		return otellog.StringValue(v.String())
+	}
+}
@@ -0,0 +1,120 @@
+package logger
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	logexp "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploggrpc"
+	loghttpexp "go.opentelemetry.io/otel/exporters/otlp/otlplog/otlploghttp"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func orDefault(d, def time.Duration) time.Duration {
+	if d <= 0 {
+		return def
+	}
+	return d
+}
+
+// newLogsExporter builds the OTLP log exporter selected by cfg.Protocol
+// ("grpc", the default, or "http/protobuf"), applying headers, compression,
+// TLS, and retry settings the same way regardless of transport.
+func newLogsExporter(ctx context.Context, cfg Config) (sdklog.Exporter, error) {
+	if cfg.Protocol == "http/protobuf" {
+		return newLogsHTTPExporter(ctx, cfg)
+	}
+	return newLogsGRPCExporter(ctx, cfg)
+}
+
+func newLogsGRPCExporter(ctx context.Context, cfg Config) (sdklog.Exporter, error) {
+	bo := backoff.Config{
+		BaseDelay:  500 * time.Millisecond,
+		Multiplier: 1.6,
+		MaxDelay:   5 * time.Second,
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           bo,
+			MinConnectTimeout: cfg.DialTimeout,
+		}),
+	}
+	switch {
+	case cfg.Insecure != nil && *cfg.Insecure:
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	case cfg.TLS != nil:
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(credentials.NewTLS(cfg.TLS)))
+	}
+
+	opts := []logexp.Option{
+		logexp.WithEndpoint(cfg.Endpoint),
+		logexp.WithDialOption(dialOpts...),
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, logexp.WithHeaders(cfg.Headers))
+	}
+	if cfg.Compression == "gzip" {
+		opts = append(opts, logexp.WithCompressor("gzip"))
+	}
+	if cfg.ExportTimeout > 0 {
+		opts = append(opts, logexp.WithTimeout(cfg.ExportTimeout))
+	}
+	if cfg.Retry.Enabled {
+		opts = append(opts, logexp.WithRetry(logexp.RetryConfig{
+			Enabled:         true,
+			InitialInterval: orDefault(cfg.Retry.InitialInterval, 5*time.Second),
+			MaxInterval:     orDefault(cfg.Retry.MaxInterval, 30*time.Second),
+			MaxElapsedTime:  orDefault(cfg.Retry.MaxElapsedTime, time.Minute),
+		}))
+	}
+
+	exp, err := logexp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("otlp/grpc log exporter: %w", err)
+	}
+	return exp, nil
+}
+
+func newLogsHTTPExporter(ctx context.Context, cfg Config) (sdklog.Exporter, error) {
+	opts := []loghttpexp.Option{
+		loghttpexp.WithEndpoint(cfg.Endpoint),
+	}
+	switch {
+	case cfg.Insecure != nil && *cfg.Insecure:
+		opts = append(opts, loghttpexp.WithInsecure())
+	case cfg.TLS != nil:
+		opts = append(opts, loghttpexp.WithTLSClientConfig(cfg.TLS))
+	}
+	if len(cfg.Headers) > 0 {
+		opts = append(opts, loghttpexp.WithHeaders(cfg.Headers))
+	}
+	switch cfg.Compression {
+	case "gzip":
+		opts = append(opts, loghttpexp.WithCompression(loghttpexp.GzipCompression))
+	case "none":
+		opts = append(opts, loghttpexp.WithCompression(loghttpexp.NoCompression))
+	}
+	if cfg.ExportTimeout > 0 {
+		opts = append(opts, loghttpexp.WithTimeout(cfg.ExportTimeout))
+	}
+	if cfg.Retry.Enabled {
+		opts = append(opts, loghttpexp.WithRetry(loghttpexp.RetryConfig{
+			Enabled:         true,
+			InitialInterval: orDefault(cfg.Retry.InitialInterval, 5*time.Second),
+			MaxInterval:     orDefault(cfg.Retry.MaxInterval, 30*time.Second),
+			MaxElapsedTime:  orDefault(cfg.Retry.MaxElapsedTime, time.Minute),
+		}))
+	}
+
+	exp, err := loghttpexp.New(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("otlp/http log exporter: %w", err)
+	}
+	return exp, nil
+}
@@ -0,0 +1,81 @@
+// Package zerologbridge adapts github.com/rs/zerolog events into the
+// global OTel LoggerProvider, so apps already using zerolog can adopt this
+// module without rewriting call sites.
+package zerologbridge
+
+import (
+	"context"
+
+	"github.com/rs/zerolog"
+
+	otellog "go.opentelemetry.io/otel/log"
+	logglobal "go.opentelemetry.io/otel/log/global"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Hook is a zerolog.Hook that mirrors every logged event that has a level
+// to the named OTel component logger (see logger.Logger), carrying its
+// level and message; fields added to the event are not carried over.
+// Attach it with
+// zlog.Hook(zerologbridge.NewHook(ctx, "http")).
+type Hook struct {
+	ctx    context.Context
+	logger otellog.Logger
+}
+
+var _ zerolog.Hook = (*Hook)(nil)
+
+// NewHook returns a Hook that emits through the named OTel component
+// logger, correlating with the active span in ctx if any. zerolog.Hook.Run
+// doesn't receive a context, so ctx is fixed at construction time; for
+// per-request correlation, attach a Hook built from the request's ctx to a
+// per-request logger (zlog.Hook(zerologbridge.NewHook(reqCtx, "http"))).
+func NewHook(ctx context.Context, name string) *Hook {
+	return &Hook{
+		ctx:    ctx,
+		logger: logglobal.GetLoggerProvider().Logger(name),
+	}
+}
+
+// Run implements zerolog.Hook. It runs synchronously as part of every
+// logged event, so it must stay cheap.
+func (h *Hook) Run(e *zerolog.Event, level zerolog.Level, message string) {
+	if level == zerolog.NoLevel || level == zerolog.Disabled {
+		return
+	}
+
+	var r otellog.Record
+	r.SetSeverity(zerologLevelToSeverity(level))
+	r.SetSeverityText(level.String())
+	r.SetBody(otellog.StringValue(message))
+
+	if sc := trace.SpanContextFromContext(h.ctx); sc.IsValid() {
+		r.AddAttributes(
+			otellog.String("trace_id", sc.TraceID().String()),
+			otellog.String("span_id", sc.SpanID().String()),
+		)
+	}
+
+	h.logger.Emit(h.ctx, r)
+}
+
+func zerologLevelToSeverity(l zerolog.Level) otellog.Severity {
+	switch l {
+	case zerolog.TraceLevel:
+		return otellog.SeverityTrace
+	case zerolog.DebugLevel:
+		return otellog.SeverityDebug
+	case zerolog.InfoLevel:
+		return otellog.SeverityInfo
+	case zerolog.WarnLevel:
+		return otellog.SeverityWarn
+	case zerolog.ErrorLevel:
+		return otellog.SeverityError
+	case zerolog.FatalLevel:
+		return otellog.SeverityFatal
+	case zerolog.PanicLevel:
+		return otellog.SeverityFatal4
+	default:
+		return otellog.SeverityInfo
+	}
+}
@@ -0,0 +1,140 @@
+package meter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	metricexp "go.opentelemetry.io/otel/exporters/otlp/otlpmetric/otlpmetricgrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdoutmetric"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Config struct {
+	// OTLP/collector address, e.g. "otel-collector:4317". If empty, uses OTEL_EXPORTER_OTLP_ENDPOINT or "localhost:4317".
+	Endpoint string
+	// If true, uses plaintext (inside cluster / local). If false, use TLS (you'll need creds).
+	Insecure bool
+
+	ServiceName string // required
+	ServiceVer  string // optional
+	Environment string // "prod" | "staging" | "dev" | etc.
+
+	// If true, also send metrics to stdout (useful for local development)
+	EnableStdout bool
+
+	// Optional tuning:
+	DialTimeout    time.Duration // default 10s
+	ExportInterval time.Duration // default 15s, periodic reader export interval
+
+	// Resource lets callers share a single resource.Resource across signals
+	// (e.g. from observability.Init). If nil, one is built from cfg + env.
+	Resource *resource.Resource
+}
+
+// InitMetrics initializes a global OTel MeterProvider. Call once at startup.
+// Returns a shutdown func you should call on exit for a clean flush.
+func InitMetrics(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if cfg.ServiceName == "" {
+		return nil, errors.New("telemetry: ServiceName is required")
+	}
+
+	if cfg.Endpoint == "" {
+		// Also respects the standard env var if you forgot to pass Endpoint.
+		cfg.Endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+		if cfg.Endpoint == "" {
+			cfg.Endpoint = "localhost:4317"
+		}
+	}
+	if cfg.DialTimeout == 0 {
+		cfg.DialTimeout = 10 * time.Second
+	}
+	if cfg.ExportInterval == 0 {
+		cfg.ExportInterval = 15 * time.Second
+	}
+
+	res := cfg.Resource
+	if res == nil {
+		var err error
+		res, err = resource.New(ctx,
+			resource.WithFromEnv(),
+			resource.WithHost(),
+			resource.WithTelemetrySDK(),
+			resource.WithAttributes(
+				semconv.ServiceName(cfg.ServiceName),
+				semconv.ServiceVersion(cfg.ServiceVer),
+				attribute.String("deployment.environment", cfg.Environment),
+			),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("resource: %w", err)
+		}
+	}
+
+	bo := backoff.Config{
+		BaseDelay:  500 * time.Millisecond,
+		Multiplier: 1.6,
+		MaxDelay:   5 * time.Second,
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           bo,
+			MinConnectTimeout: cfg.DialTimeout,
+		}),
+	}
+	if cfg.Insecure {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	// Create OTLP exporter
+	otlpExp, err := metricexp.New(ctx,
+		metricexp.WithEndpoint(cfg.Endpoint),
+		metricexp.WithDialOption(dialOpts...),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("otlp metric exporter: %w", err)
+	}
+
+	mpOptions := []sdkmetric.Option{
+		sdkmetric.WithResource(res),
+		sdkmetric.WithReader(sdkmetric.NewPeriodicReader(otlpExp,
+			sdkmetric.WithInterval(cfg.ExportInterval),
+		)),
+	}
+
+	// Optionally add stdout exporter
+	if cfg.EnableStdout {
+		stdoutExp, err := stdoutmetric.New(
+			stdoutmetric.WithPrettyPrint(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("stdout metric exporter: %w", err)
+		}
+
+		mpOptions = append(mpOptions, sdkmetric.WithReader(sdkmetric.NewPeriodicReader(stdoutExp,
+			sdkmetric.WithInterval(cfg.ExportInterval),
+		)))
+	}
+
+	mp := sdkmetric.NewMeterProvider(mpOptions...)
+	otel.SetMeterProvider(mp)
+
+	return mp.Shutdown, nil
+}
+
+// Meter returns a named component meter (e.g., "http", "db", "worker").
+func Meter(name string) metric.Meter {
+	return otel.GetMeterProvider().Meter(name)
+}
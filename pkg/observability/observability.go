@@ -0,0 +1,109 @@
+// Package observability wires up the logs, traces, and metrics subsystems
+// of this module against a single shared resource.Resource, so that all
+// three signals agree on service.name, service.version, and deployment
+// attributes.
+package observability
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/resource"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+
+	"github.com/rotem-ashkenazi/go-observability/pkg/logger"
+	"github.com/rotem-ashkenazi/go-observability/pkg/meter"
+	"github.com/rotem-ashkenazi/go-observability/pkg/tracer"
+)
+
+// Config bundles the per-signal configs. ServiceName/ServiceVer/Environment
+// are set once here and propagated to Logs/Traces/Metrics; per-signal fields
+// (endpoints, stdout, tuning) are still set on the nested configs.
+type Config struct {
+	ServiceName string // required
+	ServiceVer  string // optional
+	Environment string // "prod" | "staging" | "dev" | etc.
+
+	Logs    logger.Config
+	Traces  tracer.Config
+	Metrics meter.Config
+}
+
+// Init initializes the LoggerProvider, TracerProvider, and MeterProvider
+// against a shared resource.Resource, installing each as the global
+// provider for its signal. Call once at startup.
+//
+// The returned shutdown func flushes and closes all three providers in
+// reverse order (metrics, then traces, then logs) and returns a joined
+// error if any shutdown fails.
+func Init(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if cfg.ServiceName == "" {
+		return nil, errors.New("telemetry: ServiceName is required")
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithFromEnv(),
+		resource.WithHost(),
+		resource.WithTelemetrySDK(),
+		resource.WithAttributes(
+			semconv.ServiceName(cfg.ServiceName),
+			semconv.ServiceVersion(cfg.ServiceVer),
+			attribute.String("deployment.environment", cfg.Environment),
+		),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("resource: %w", err)
+	}
+
+	cfg.Logs.ServiceName = cfg.ServiceName
+	cfg.Logs.ServiceVer = cfg.ServiceVer
+	cfg.Logs.Environment = cfg.Environment
+	cfg.Logs.Resource = res
+
+	cfg.Traces.ServiceName = cfg.ServiceName
+	cfg.Traces.ServiceVer = cfg.ServiceVer
+	cfg.Traces.Environment = cfg.Environment
+	cfg.Traces.Resource = res
+
+	cfg.Metrics.ServiceName = cfg.ServiceName
+	cfg.Metrics.ServiceVer = cfg.ServiceVer
+	cfg.Metrics.Environment = cfg.Environment
+	cfg.Metrics.Resource = res
+
+	shutdownLogs, err := logger.InitLogs(ctx, cfg.Logs)
+	if err != nil {
+		return nil, fmt.Errorf("init logs: %w", err)
+	}
+
+	shutdownTraces, err := tracer.InitTraces(ctx, cfg.Traces)
+	if err != nil {
+		_ = shutdownLogs(ctx)
+		return nil, fmt.Errorf("init traces: %w", err)
+	}
+
+	shutdownMetrics, err := meter.InitMetrics(ctx, cfg.Metrics)
+	if err != nil {
+		_ = shutdownTraces(ctx)
+		_ = shutdownLogs(ctx)
+		return nil, fmt.Errorf("init metrics: %w", err)
+	}
+
+	// Flush in reverse order: metrics, traces, logs.
+	shutdown := func(ctx context.Context) error {
+		var errs []error
+		if err := shutdownMetrics(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutdown metrics: %w", err))
+		}
+		if err := shutdownTraces(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutdown traces: %w", err))
+		}
+		if err := shutdownLogs(ctx); err != nil {
+			errs = append(errs, fmt.Errorf("shutdown logs: %w", err))
+		}
+		return errors.Join(errs...)
+	}
+
+	return shutdown, nil
+}
@@ -0,0 +1,147 @@
+package tracer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	traceexp "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.24.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/backoff"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+type Config struct {
+	// OTLP/collector address, e.g. "otel-collector:4317". If empty, uses OTEL_EXPORTER_OTLP_ENDPOINT or "localhost:4317".
+	Endpoint string
+	// If true, uses plaintext (inside cluster / local). If false, use TLS (you'll need creds).
+	Insecure bool
+
+	ServiceName string // required
+	ServiceVer  string // optional
+	Environment string // "prod" | "staging" | "dev" | etc.
+
+	// If true, also send spans to stdout (useful for local development)
+	EnableStdout bool
+
+	// Optional tuning:
+	DialTimeout    time.Duration // default 10s
+	ExportInterval time.Duration // default 5s, batch span processor schedule delay
+	MaxQueueSize   int           // default 2048
+
+	// Resource lets callers share a single resource.Resource across signals
+	// (e.g. from observability.Init). If nil, one is built from cfg + env.
+	Resource *resource.Resource
+}
+
+// InitTraces initializes a global OTel TracerProvider. Call once at startup.
+// Returns a shutdown func you should call on exit for a clean flush.
+func InitTraces(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if cfg.ServiceName == "" {
+		return nil, errors.New("telemetry: ServiceName is required")
+	}
+
+	if cfg.Endpoint == "" {
+		// Also respects the standard env var if you forgot to pass Endpoint.
+		cfg.Endpoint = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
+		if cfg.Endpoint == "" {
+			cfg.Endpoint = "localhost:4317"
+		}
+	}
+	if cfg.DialTimeout == 0 {
+		cfg.DialTimeout = 10 * time.Second
+	}
+	if cfg.ExportInterval == 0 {
+		cfg.ExportInterval = 5 * time.Second
+	}
+	if cfg.MaxQueueSize == 0 {
+		cfg.MaxQueueSize = 2048
+	}
+
+	res := cfg.Resource
+	if res == nil {
+		var err error
+		res, err = resource.New(ctx,
+			resource.WithFromEnv(),
+			resource.WithHost(),
+			resource.WithTelemetrySDK(),
+			resource.WithAttributes(
+				semconv.ServiceName(cfg.ServiceName),
+				semconv.ServiceVersion(cfg.ServiceVer),
+				attribute.String("deployment.environment", cfg.Environment),
+			),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("resource: %w", err)
+		}
+	}
+
+	bo := backoff.Config{
+		BaseDelay:  500 * time.Millisecond,
+		Multiplier: 1.6,
+		MaxDelay:   5 * time.Second,
+	}
+	dialOpts := []grpc.DialOption{
+		grpc.WithBlock(),
+		grpc.WithConnectParams(grpc.ConnectParams{
+			Backoff:           bo,
+			MinConnectTimeout: cfg.DialTimeout,
+		}),
+	}
+	if cfg.Insecure {
+		dialOpts = append(dialOpts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	}
+
+	// Create OTLP exporter
+	otlpExp, err := traceexp.New(ctx,
+		traceexp.WithEndpoint(cfg.Endpoint),
+		traceexp.WithDialOption(dialOpts...),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("otlp trace exporter: %w", err)
+	}
+
+	// Create batch processor for OTLP
+	otlpProcessor := sdktrace.NewBatchSpanProcessor(otlpExp,
+		sdktrace.WithBatchTimeout(cfg.ExportInterval),
+		sdktrace.WithMaxQueueSize(cfg.MaxQueueSize),
+	)
+
+	tpOptions := []sdktrace.TracerProviderOption{
+		sdktrace.WithResource(res),
+		sdktrace.WithSpanProcessor(otlpProcessor),
+	}
+
+	// Optionally add stdout exporter
+	if cfg.EnableStdout {
+		stdoutExp, err := stdouttrace.New(
+			stdouttrace.WithPrettyPrint(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf("stdout trace exporter: %w", err)
+		}
+
+		// Use simple processor for stdout (immediate output)
+		tpOptions = append(tpOptions, sdktrace.WithSpanProcessor(sdktrace.NewSimpleSpanProcessor(stdoutExp)))
+	}
+
+	tp := sdktrace.NewTracerProvider(tpOptions...)
+	otel.SetTracerProvider(tp)
+
+	return tp.Shutdown, nil
+}
+
+// Tracer returns a named component tracer (e.g., "http", "db", "worker").
+func Tracer(name string) trace.Tracer {
+	return otel.GetTracerProvider().Tracer(name)
+}